@@ -1,24 +1,77 @@
 package pcf8523
 
 import (
+	"context"
 	"errors"
 	"time"
 
+	"periph.io/x/periph/conn/gpio"
 	"periph.io/x/periph/conn/i2c"
 	"periph.io/x/periph/conn/i2c/i2creg"
 )
 
-type Pcf8523 struct {
-	Device i2c.Dev
-	bus i2c.BusCloser
+// Control_1 register (0x00) bits used so far.
+const (
+	control1CapSel = 0x80
+	control1Stop   = 0x20
+	control1Aie    = 0x02
+)
+
+// Control_2 register (0x01) bits used so far.
+const (
+	control2Wtaf  = 0x80
+	control2Ctaf  = 0x40
+	control2Ctbf  = 0x20
+	control2Sf    = 0x10
+	control2Af    = 0x08
+	control2Wtaie = 0x04
+	control2Ctaie = 0x02
+	control2Ctbie = 0x01
+)
+
+// Tmr_CLKOUT_ctrl register (0x0F) bits: TAM[7]/TBM[6] are left untouched,
+// COF[5:3] selects the CLKOUT frequency, TAC[2:1] selects the Timer A mode,
+// and TBC[0] enables Timer B.
+const (
+	tmrClkoutCofMask  = 0x38
+	tmrClkoutCofShift = 3
+	tmrClkoutTacMask  = 0x06
+	tmrClkoutTbcMask  = 0x01
+
+	tacDisabled  = 0x00
+	tacCountdown = 0x01 << 1
+	tacWatchdog  = 0x02 << 1
+	tbcEnabled   = 0x01
+)
+
+// Variant identifies which member of the NXP PCF8523/PCF2127/PCF2129 family a
+// Device is talking to. The three chips share most of the register map, but
+// the PCF2127/PCF2129 add tamper-timestamp support, and the PCF2127 also adds
+// on-chip RAM.
+type Variant int
+
+const (
+	VariantPCF8523 Variant = iota
+	VariantPCF2127
+	VariantPCF2129
+)
+
+type Device struct {
+	Device  i2c.Dev
+	bus     i2c.BusCloser
+	variant Variant
 }
 
-func (p *Pcf8523) Close() {
+// Pcf8523 is kept as an alias of Device for backwards compatibility with code
+// written against the original PCF8523-only API.
+type Pcf8523 = Device
+
+func (p *Device) Close() {
 	p.bus.Close()
 }
 
 // Reads a single register at the given address
-func (p *Pcf8523) ReadReg(address byte) (byte, error) {
+func (p *Device) ReadReg(address byte) (byte, error) {
 	w := []byte{address}
 	r := make([]byte, 1)
 	if err := p.Device.Tx(w, r); err != nil {
@@ -28,7 +81,7 @@ func (p *Pcf8523) ReadReg(address byte) (byte, error) {
 }
 
 // Sets a single register at the given address
-func (p *Pcf8523) WriteReg(address, value byte) error {
+func (p *Device) WriteReg(address, value byte) error {
 	w := []byte{address, value}
 	return p.Device.Tx(w, []byte{})
 }
@@ -37,7 +90,7 @@ func (p *Pcf8523) WriteReg(address, value byte) error {
 // The first argument configures whether switching over to battery is enabled.
 // The second argument should be true if switching should happen when Vdd < Vbat
 // The third argument enables whether battery low detection is enabled. The battery status can be checked by calling IsBatteryLow.
-func (p *Pcf8523) ConfigurePowerManagement(switchover, directSwitchingMode, batteryLowDetection bool) error {
+func (p *Device) ConfigurePowerManagement(switchover, directSwitchingMode, batteryLowDetection bool) error {
 	value := 0
 	if directSwitchingMode {
 		value |= 0x1
@@ -55,12 +108,12 @@ func (p *Pcf8523) ConfigurePowerManagement(switchover, directSwitchingMode, batt
 }
 
 // Returns true if the BLF flag is set.
-func (p *Pcf8523) IsBatteryLow() (bool, error) {
+func (p *Device) IsBatteryLow() (bool, error) {
 	val, err := p.ReadReg(0x02)
 	return val&0x4 != 0, err
 }
 
-func (p *Pcf8523) getCorrection() (int8, error) {
+func (p *Device) getCorrection() (int8, error) {
 	c, err := p.ReadReg(0xE)
 	if err != nil {
 		return 0, err
@@ -85,7 +138,7 @@ func (p *Pcf8523) getCorrection() (int8, error) {
 // 20 seconds per day, then the register will be set to 23.62 seconds per day.
 //
 // This function always uses the two hour correction mode.
-func (p *Pcf8523) AddTimeCorrection(secondsPerDay float64) error {
+func (p *Device) AddTimeCorrection(secondsPerDay float64) error {
 	// 1 (second/day) = 11.57407PPM
 	// 1 LSB = 4.34PPM (in 2 hour mode)
 	offset := int8(secondsPerDay * 11.57407 / 4.34)
@@ -107,7 +160,7 @@ func (p *Pcf8523) AddTimeCorrection(secondsPerDay float64) error {
 //
 // If you want to set the time correction register to a specific value, call this register
 // before calling AddTimeCorrection
-func (p *Pcf8523) ResetTimeCorrection() error {
+func (p *Device) ResetTimeCorrection() error {
 	return p.WriteReg(0xE, 0x00)
 }
 
@@ -119,11 +172,21 @@ func encodeBcd(value int) byte {
 	return byte((value % 10) | ((value / 10) << 4))
 }
 
+// Sets the bits in mask of the register at address to the corresponding bits of
+// value, leaving all other bits in the register untouched.
+func (p *Device) readModifyWrite(address, mask, value byte) error {
+	current, err := p.ReadReg(address)
+	if err != nil {
+		return err
+	}
+	return p.WriteReg(address, (current&^mask)|(value&mask))
+}
+
 // Read the time. This function reads all the registers at once, so the result is guaranteed
 // to be coherent.
 //
 // The time stored on the module is assumed to be in UTC and between the years 2000 and 2100
-func (p *Pcf8523) GetTime() (time.Time, error) {
+func (p *Device) GetTime() (time.Time, error) {
 	w := []byte{0x03}
 	r := make([]byte, 7)
 	if err := p.Device.Tx(w, r); err != nil {
@@ -141,14 +204,22 @@ func (p *Pcf8523) GetTime() (time.Time, error) {
 	), nil
 }
 
-// Sets the time. All registers are written in a single transaction, so the time is
-// guaranteed to be set coherently.
+// Sets the time. STOP is set before writing so the divider chain is frozen
+// while the time registers are loaded - without it, a second rollover mid-write
+// could corrupt the written time - and cleared again once the write completes.
+// All seven time registers are written in a single transaction, which also
+// clears the OS flag since the written seconds byte always has bit 7 clear.
 //
 // Assumes the input year is between 2000 and 2100, and converts the provided time to UTC
-func (p *Pcf8523) SetTime(date time.Time) error {
+func (p *Device) SetTime(date time.Time) error {
 	date = date.In(time.UTC)
 
-	// Set the time
+	if err := p.readModifyWrite(0x00, control1Stop, control1Stop); err != nil {
+		return err
+	}
+
+	// Set the time. The OS flag is cleared as a side effect, since it shares
+	// the seconds register and this write always leaves bit 7 clear.
 	w := []byte{
 		0x03,
 		encodeBcd(date.Second()),
@@ -159,47 +230,504 @@ func (p *Pcf8523) SetTime(date time.Time) error {
 		encodeBcd(int(date.Month())),
 		encodeBcd(date.Year() - 2000),
 	}
-	r := []byte{}
+	if err := p.Device.Tx(w, []byte{}); err != nil {
+		return err
+	}
+
+	return p.readModifyWrite(0x00, control1Stop, 0)
+}
+
+// CrystalLoad selects the load capacitance CAP_SEL assumes for the crystal
+// wired to OSCI/OSCO.
+type CrystalLoad byte
+
+const (
+	Load12_5pF CrystalLoad = 0
+	Load7pF    CrystalLoad = 1
+)
+
+// Sets the crystal load capacitance (CAP_SEL in Control_1), preserving the
+// other Control_1 bits. CAP_SEL=0 selects 7pF, CAP_SEL=1 selects 12.5pF.
+func (p *Device) SetCrystalLoad(load CrystalLoad) error {
+	value := byte(0)
+	if load == Load12_5pF {
+		value = control1CapSel
+	}
+	return p.readModifyWrite(0x00, control1CapSel, value)
+}
+
+// alarmDisableBit marks an alarm match register as "don't care" (AE_x in the
+// datasheet).
+const alarmDisableBit = 0x80
+
+// Alarm represents a minute/hour/day/weekday alarm match configuration for the
+// PCF8523. Each field is optional: a nil field means "don't care" and is
+// written to the chip with its alarm-enable bit set so that field is ignored
+// when the alarm is matched against the current time.
+type Alarm struct {
+	Minute  *int
+	Hour    *int
+	Day     *int
+	Weekday *int
+}
+
+func encodeAlarmField(value *int) byte {
+	if value == nil {
+		return alarmDisableBit
+	}
+	return encodeBcd(*value)
+}
+
+func decodeAlarmField(reg byte) *int {
+	if reg&alarmDisableBit != 0 {
+		return nil
+	}
+	value := parseBcd(reg &^ alarmDisableBit)
+	return &value
+}
+
+// Sets the minute/hour/day/weekday alarm match registers. All four registers
+// are written in a single transaction, so the alarm is always coherent. Fields
+// left nil in alarm are marked "don't care".
+func (p *Device) SetAlarm(alarm Alarm) error {
+	w := []byte{
+		0x0A,
+		encodeAlarmField(alarm.Minute),
+		encodeAlarmField(alarm.Hour),
+		encodeAlarmField(alarm.Day),
+		encodeAlarmField(alarm.Weekday),
+	}
+	return p.Device.Tx(w, []byte{})
+}
+
+// Reads back the alarm match registers set by SetAlarm, along with whether the
+// alarm interrupt is enabled (AIE in Control_1).
+func (p *Device) GetAlarm() (Alarm, bool, error) {
+	w := []byte{0x0A}
+	r := make([]byte, 4)
 	if err := p.Device.Tx(w, r); err != nil {
+		return Alarm{}, false, err
+	}
+
+	alarm := Alarm{
+		Minute:  decodeAlarmField(r[0]),
+		Hour:    decodeAlarmField(r[1]),
+		Day:     decodeAlarmField(r[2]),
+		Weekday: decodeAlarmField(r[3]),
+	}
+
+	control1, err := p.ReadReg(0x00)
+	if err != nil {
+		return alarm, false, err
+	}
+	return alarm, control1&control1Aie != 0, nil
+}
+
+// Clears the AF alarm flag in Control_2, leaving the other flags and enables
+// untouched.
+func (p *Device) ClearAlarmFlag() error {
+	return p.readModifyWrite(0x01, control2Af, 0)
+}
+
+// Enables or disables the alarm interrupt (AIE in Control_1).
+func (p *Device) EnableAlarmInterrupt(enable bool) error {
+	value := byte(0)
+	if enable {
+		value = control1Aie
+	}
+	return p.readModifyWrite(0x00, control1Aie, value)
+}
+
+// Returns true if the AF alarm flag is currently set.
+func (p *Device) IsAlarmTriggered() (bool, error) {
+	control2, err := p.ReadReg(0x01)
+	if err != nil {
+		return false, err
+	}
+	return control2&control2Af != 0, nil
+}
+
+// Blocks until the alarm flag is set or ctx is done, polling at the given
+// interval. Useful on boards that don't have the INT1 pin wired up.
+func (p *Device) WaitAlarm(ctx context.Context, pollInterval time.Duration) error {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			triggered, err := p.IsAlarmTriggered()
+			if err != nil {
+				return err
+			}
+			if triggered {
+				return nil
+			}
+		}
+	}
+}
+
+// ClkoutFreq selects the square wave frequency driven on the CLKOUT pin.
+type ClkoutFreq byte
+
+const (
+	Clkout32768Hz  ClkoutFreq = 0
+	Clkout16384Hz  ClkoutFreq = 1
+	Clkout8192Hz   ClkoutFreq = 2
+	Clkout4096Hz   ClkoutFreq = 3
+	Clkout1024Hz   ClkoutFreq = 4
+	Clkout32Hz     ClkoutFreq = 5
+	Clkout1Hz      ClkoutFreq = 6
+	ClkoutDisabled ClkoutFreq = 7
+)
+
+// Sets the CLKOUT output frequency (COF in Tmr_CLKOUT_ctrl), preserving the
+// Timer A/B configuration bits in the same register.
+func (p *Device) SetClkoutFrequency(freq ClkoutFreq) error {
+	return p.readModifyWrite(0x0F, tmrClkoutCofMask, byte(freq)<<tmrClkoutCofShift)
+}
+
+// TimerMode selects how Timer A/B behaves once configured.
+type TimerMode int
+
+const (
+	TimerDisabled TimerMode = iota
+	TimerCountdown
+	TimerWatchdog
+)
+
+// TimerSource selects the clock source driving the Timer A/B countdown.
+type TimerSource byte
+
+const (
+	TimerSource4096Hz TimerSource = 0
+	TimerSource64Hz   TimerSource = 1
+	TimerSource1Hz    TimerSource = 2
+	TimerSource1_60Hz TimerSource = 3
+)
+
+// Configures Timer A as disabled, a countdown timer, or a watchdog, ticking at
+// the given source frequency and counting down from value. Watchdog mode
+// resets the chip's INT1 line if the countdown reaches zero before being
+// reloaded; countdown mode just sets the CTAF flag.
+func (p *Device) ConfigureTimerA(mode TimerMode, src TimerSource, value uint8) error {
+	if err := p.WriteReg(0x10, byte(src)&0x07); err != nil {
+		return err
+	}
+	if err := p.WriteReg(0x11, value); err != nil {
 		return err
 	}
 
-	// Clear the OS flag
-	return nil
+	tac := byte(tacDisabled)
+	switch mode {
+	case TimerCountdown:
+		tac = tacCountdown
+	case TimerWatchdog:
+		tac = tacWatchdog
+	}
+	return p.readModifyWrite(0x0F, tmrClkoutTacMask, tac)
 }
 
-// Creates a new PCF8523 device at the given I2C address on the given bus.
-//
-// Tries to clear the oscillator stop (OS) flag. If clearing it fails, returns an error.
-func NewPcf8523(path string, i2caddr uint16) (Pcf8523, error) {
+// Configures Timer B as disabled or a countdown timer, ticking at the given
+// source frequency and counting down from value. Timer B has no watchdog mode.
+func (p *Device) ConfigureTimerB(mode TimerMode, src TimerSource, value uint8) error {
+	if mode == TimerWatchdog {
+		return errors.New("Timer B does not support watchdog mode")
+	}
+	if err := p.WriteReg(0x12, byte(src)&0x07); err != nil {
+		return err
+	}
+	if err := p.WriteReg(0x13, value); err != nil {
+		return err
+	}
+
+	tbc := byte(0)
+	if mode == TimerCountdown {
+		tbc = tbcEnabled
+	}
+	return p.readModifyWrite(0x0F, tmrClkoutTbcMask, tbc)
+}
+
+// Returns true if Timer A's countdown or watchdog flag is set.
+func (p *Device) IsTimerAFlagSet() (bool, error) {
+	control2, err := p.ReadReg(0x01)
+	if err != nil {
+		return false, err
+	}
+	return control2&(control2Ctaf|control2Wtaf) != 0, nil
+}
+
+// Clears Timer A's countdown and watchdog flags, leaving the other Control_2
+// flags and enables untouched.
+func (p *Device) ClearTimerAFlag() error {
+	return p.readModifyWrite(0x01, control2Ctaf|control2Wtaf, 0)
+}
+
+// Enables or disables the Timer A interrupt (CTAIE/WTAIE in Control_2).
+func (p *Device) EnableTimerAInterrupt(enable bool) error {
+	value := byte(0)
+	if enable {
+		value = control2Ctaie | control2Wtaie
+	}
+	return p.readModifyWrite(0x01, control2Ctaie|control2Wtaie, value)
+}
+
+// Returns true if Timer B's countdown flag is set.
+func (p *Device) IsTimerBFlagSet() (bool, error) {
+	control2, err := p.ReadReg(0x01)
+	if err != nil {
+		return false, err
+	}
+	return control2&control2Ctbf != 0, nil
+}
+
+// Clears Timer B's countdown flag, leaving the other Control_2 flags and
+// enables untouched.
+func (p *Device) ClearTimerBFlag() error {
+	return p.readModifyWrite(0x01, control2Ctbf, 0)
+}
+
+// Enables or disables the Timer B interrupt (CTBIE in Control_2).
+func (p *Device) EnableTimerBInterrupt(enable bool) error {
+	value := byte(0)
+	if enable {
+		value = control2Ctbie
+	}
+	return p.readModifyWrite(0x01, control2Ctbie, value)
+}
+
+// Returns true if the OS oscillator-stop flag is set, without modifying it.
+// OS is set whenever the oscillator has lost time reference (e.g. Vdd and
+// Vbat were both lost), meaning the time/config may no longer be valid.
+func (p *Device) OscillatorStopped() (bool, error) {
+	seconds_reg, err := p.ReadReg(0x03)
+	if err != nil {
+		return false, err
+	}
+	return seconds_reg&0x80 != 0, nil
+}
+
+// Clears the OS oscillator-stop flag, leaving the seconds value in the same
+// register untouched.
+func (p *Device) ClearOscillatorStopped() error {
+	seconds_reg, err := p.ReadReg(0x03)
+	if err != nil {
+		return err
+	}
+	return p.WriteReg(0x03, seconds_reg&0x7F)
+}
+
+// Event identifies which Control_2 flag fired the INT1 edge observed by Subscribe.
+type Event int
+
+const (
+	AlarmEvent Event = iota
+	CountdownAEvent
+	CountdownBEvent
+	SecondEvent
+	WatchdogEvent
+)
+
+// Watches pin (expected to be wired to INT1) for edges and, on each one, reads
+// Control_2 and sends an Event for every flag that is set, clearing those
+// flags as they're dispatched. The returned channel is closed once ctx is done.
+func (p *Device) Subscribe(ctx context.Context, pin gpio.PinIO) (<-chan Event, error) {
+	if err := pin.In(gpio.PullNoChange, gpio.FallingEdge); err != nil {
+		return nil, err
+	}
+
+	events := make(chan Event)
+	go func() {
+		defer close(events)
+		send := func(e Event) bool {
+			select {
+			case events <- e:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			default:
+			}
+
+			if !pin.WaitForEdge(time.Second) {
+				continue
+			}
+
+			control2, err := p.ReadReg(0x01)
+			if err != nil {
+				continue
+			}
+
+			var clearMask byte
+			if control2&control2Af != 0 {
+				clearMask |= control2Af
+				if !send(AlarmEvent) {
+					return
+				}
+			}
+			if control2&(control2Ctaf|control2Wtaf) != 0 {
+				clearMask |= control2Ctaf | control2Wtaf
+				event := CountdownAEvent
+				if control2&control2Wtaf != 0 {
+					event = WatchdogEvent
+				}
+				if !send(event) {
+					return
+				}
+			}
+			if control2&control2Ctbf != 0 {
+				clearMask |= control2Ctbf
+				if !send(CountdownBEvent) {
+					return
+				}
+			}
+			if control2&control2Sf != 0 {
+				clearMask |= control2Sf
+				if !send(SecondEvent) {
+					return
+				}
+			}
+
+			if clearMask != 0 {
+				p.readModifyWrite(0x01, clearMask, 0)
+			}
+		}
+	}()
+
+	return events, nil
+}
+
+// Opens the device at the given I2C address/bus and tries to clear the
+// oscillator stop (OS) flag. Per DOC 1, the OS flag - not the power-manager
+// field - is what a caller should check to decide whether the chip's
+// configuration needs to be re-applied after a power event, since it is set
+// whenever the oscillator has stopped and the time/config may be invalid.
+func newDevice(path string, i2caddr uint16, variant Variant) (Device, error) {
 	b, err := i2creg.Open(path)
 	if err != nil {
-		return Pcf8523{}, err
+		return Device{}, err
 	}
 
-	d := i2c.Dev{Addr: i2caddr, Bus: b}
-	p := Pcf8523{bus: b, Device: d}
+	dev := i2c.Dev{Addr: i2caddr, Bus: b}
+	d := Device{bus: b, Device: dev, variant: variant}
 
 	// Check the oscillator state
-	seconds_reg,err := p.ReadReg(0x03)
+	seconds_reg,err := d.ReadReg(0x03)
 	if err != nil {
-		return Pcf8523{}, err
+		return Device{}, err
 	}
 	if seconds_reg&0x80 != 0 {
 		// Try to clear it, write back the number of seconds
-		if p.WriteReg(0x03, seconds_reg & 0x7F) != nil {
-			return Pcf8523{}, err
+		if err := d.WriteReg(0x03, seconds_reg & 0x7F); err != nil {
+			return Device{}, err
 		}
 
 		// If it's still dead, fail
-		seconds_reg,err := p.ReadReg(0x03)
+		seconds_reg,err := d.ReadReg(0x03)
 		if err != nil {
-			return Pcf8523{}, err
+			return Device{}, err
 		}
 		if seconds_reg&0x80 != 0 {
-			return Pcf8523{}, errors.New("PCF8523 oscillator stopped")
+			return Device{}, errors.New("oscillator stopped")
 		}
 	}
 
-	return p, nil
+	return d, nil
+}
+
+// Creates a new PCF8523 device at the given I2C address on the given bus.
+//
+// Tries to clear the oscillator stop (OS) flag. If clearing it fails, returns an error.
+func NewPcf8523(path string, i2caddr uint16) (Pcf8523, error) {
+	return newDevice(path, i2caddr, VariantPCF8523)
+}
+
+// Creates a new PCF2127 device at the given I2C address on the given bus. The
+// PCF2127 adds 512 bytes of on-chip RAM (see ReadRAM/WriteRAM) and a tamper
+// timestamp block (see ReadTamperTimestamp) on top of the PCF8523 register map.
+func NewPCF2127(path string, i2caddr uint16) (Device, error) {
+	return newDevice(path, i2caddr, VariantPCF2127)
+}
+
+// Creates a new PCF2129 device at the given I2C address on the given bus. The
+// PCF2129 adds a tamper timestamp block (see ReadTamperTimestamp) on top of
+// the PCF8523 register map.
+func NewPCF2129(path string, i2caddr uint16) (Device, error) {
+	return newDevice(path, i2caddr, VariantPCF2129)
+}
+
+// RAM access registers on the PCF2127 (a single auto-incrementing data port
+// over the 512-byte RAM, addressed via an MSB/LSB address pair). RAM_wrt_cmd and
+// RAM_rd_cmd are separate ports - writes go through 0x1C, reads through 0x1D.
+const (
+	ramAddrMsbReg = 0x1A
+	ramAddrLsbReg = 0x1B
+	ramWriteReg   = 0x1C
+	ramReadReg    = 0x1D
+)
+
+func (d *Device) setRamAddress(offset uint16) error {
+	w := []byte{ramAddrMsbReg, byte(offset >> 8), byte(offset)}
+	return d.Device.Tx(w, []byte{})
+}
+
+// Reads len(buf) bytes of on-chip RAM starting at offset. Only supported on
+// the PCF2127.
+func (d *Device) ReadRAM(offset uint16, buf []byte) error {
+	if d.variant != VariantPCF2127 {
+		return errors.New("RAM is only available on the PCF2127 variant")
+	}
+	if err := d.setRamAddress(offset); err != nil {
+		return err
+	}
+	return d.Device.Tx([]byte{ramReadReg}, buf)
+}
+
+// Writes data to on-chip RAM starting at offset. Only supported on the PCF2127.
+func (d *Device) WriteRAM(offset uint16, data []byte) error {
+	if d.variant != VariantPCF2127 {
+		return errors.New("RAM is only available on the PCF2127 variant")
+	}
+	if err := d.setRamAddress(offset); err != nil {
+		return err
+	}
+	w := append([]byte{ramWriteReg}, data...)
+	return d.Device.Tx(w, []byte{})
+}
+
+// Tamper timestamp registers, latched by the PCF2127/PCF2129 on a tamper
+// detect event. Unlike the main time registers, there is no weekday byte:
+// Sec/Min/Hour/Day/Month/Year are six consecutive registers starting at
+// Timestp_sec.
+const tamperTimestampReg = 0x13
+
+// Reads the timestamp latched by the tamper-detect input. Only supported on
+// the PCF2127/PCF2129.
+func (d *Device) ReadTamperTimestamp() (time.Time, error) {
+	if d.variant == VariantPCF8523 {
+		return time.Time{}, errors.New("tamper timestamp is only available on the PCF2127/PCF2129 variants")
+	}
+
+	w := []byte{tamperTimestampReg}
+	r := make([]byte, 6)
+	if err := d.Device.Tx(w, r); err != nil {
+		return time.Time{}, err
+	}
+	return time.Date(
+		2000 + parseBcd(r[5]),
+		time.Month(parseBcd(r[4])),
+		parseBcd(r[3]),
+		parseBcd(r[2]),
+		parseBcd(r[1]),
+		parseBcd(r[0]) & 0x7F,
+		0, // Nanoseconds
+		time.UTC,
+	), nil
 }
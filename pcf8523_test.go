@@ -0,0 +1,372 @@
+package pcf8523
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"periph.io/x/periph/conn/gpio"
+	"periph.io/x/periph/conn/i2c"
+)
+
+// fakeBus is a minimal i2c.Bus that records every Tx call and replays a
+// queue of canned read responses. Embedding the nil i2c.Bus interface lets
+// it satisfy the interface without stubbing out methods this package never
+// calls (e.g. SetSpeed).
+type fakeBus struct {
+	i2c.Bus
+	writes [][]byte
+	reads  [][]byte
+	idx    int
+	err    error
+}
+
+func (f *fakeBus) Tx(addr uint16, w, r []byte) error {
+	f.writes = append(f.writes, append([]byte(nil), w...))
+	if f.err != nil {
+		return f.err
+	}
+	if len(r) > 0 && f.idx < len(f.reads) {
+		copy(r, f.reads[f.idx])
+		f.idx++
+	}
+	return nil
+}
+
+func newTestDevice(bus *fakeBus) Device {
+	return Device{Device: i2c.Dev{Addr: 0x68, Bus: bus}}
+}
+
+func TestSetAlarm(t *testing.T) {
+	minute, hour := 30, 7
+	bus := &fakeBus{}
+	d := newTestDevice(bus)
+
+	if err := d.SetAlarm(Alarm{Minute: &minute, Hour: &hour}); err != nil {
+		t.Fatalf("SetAlarm: %v", err)
+	}
+
+	if len(bus.writes) != 1 {
+		t.Fatalf("got %d writes, want 1 (single coherent Tx)", len(bus.writes))
+	}
+	want := []byte{0x0A, encodeBcd(30), encodeBcd(7), alarmDisableBit, alarmDisableBit}
+	if !bytes.Equal(bus.writes[0], want) {
+		t.Errorf("SetAlarm wrote %#v, want %#v", bus.writes[0], want)
+	}
+}
+
+func TestGetAlarm(t *testing.T) {
+	bus := &fakeBus{reads: [][]byte{
+		{encodeBcd(30), alarmDisableBit, encodeBcd(15), alarmDisableBit},
+		{control1Aie},
+	}}
+	d := newTestDevice(bus)
+
+	alarm, enabled, err := d.GetAlarm()
+	if err != nil {
+		t.Fatalf("GetAlarm: %v", err)
+	}
+	if !enabled {
+		t.Errorf("enabled = false, want true")
+	}
+	if alarm.Minute == nil || *alarm.Minute != 30 {
+		t.Errorf("Minute = %v, want 30", alarm.Minute)
+	}
+	if alarm.Hour != nil {
+		t.Errorf("Hour = %v, want nil (don't-care)", alarm.Hour)
+	}
+	if alarm.Day == nil || *alarm.Day != 15 {
+		t.Errorf("Day = %v, want 15", alarm.Day)
+	}
+	if alarm.Weekday != nil {
+		t.Errorf("Weekday = %v, want nil (don't-care)", alarm.Weekday)
+	}
+}
+
+func TestEnableAlarmInterruptPreservesOtherBits(t *testing.T) {
+	bus := &fakeBus{reads: [][]byte{{0x55}}}
+	d := newTestDevice(bus)
+
+	if err := d.EnableAlarmInterrupt(true); err != nil {
+		t.Fatalf("EnableAlarmInterrupt: %v", err)
+	}
+
+	if len(bus.writes) != 2 {
+		t.Fatalf("got %d writes, want 2 (RMW read + write)", len(bus.writes))
+	}
+	got := bus.writes[1]
+	want := byte(0x55) | 0x02 // control1Aie set, other bits of 0x55 preserved
+	if got[0] != 0x00 || got[1] != want {
+		t.Errorf("wrote %#v, want addr 0x00 value %#x", got, want)
+	}
+}
+
+func TestSetClkoutFrequencyBitPosition(t *testing.T) {
+	bus := &fakeBus{reads: [][]byte{{0x00}}}
+	d := newTestDevice(bus)
+
+	if err := d.SetClkoutFrequency(Clkout1Hz); err != nil {
+		t.Fatalf("SetClkoutFrequency: %v", err)
+	}
+
+	got := bus.writes[1]
+	want := byte(0x30) // COF=6 at bits 5:3
+	if got[0] != 0x0F || got[1] != want {
+		t.Errorf("wrote %#v, want addr 0x0F value %#x", got, want)
+	}
+}
+
+func TestConfigureTimerACountdownPreservesOtherBits(t *testing.T) {
+	bus := &fakeBus{reads: [][]byte{{0xFF}}}
+	d := newTestDevice(bus)
+
+	if err := d.ConfigureTimerA(TimerCountdown, TimerSource64Hz, 100); err != nil {
+		t.Fatalf("ConfigureTimerA: %v", err)
+	}
+
+	if bus.writes[0][0] != 0x10 || bus.writes[0][1] != byte(TimerSource64Hz) {
+		t.Errorf("Tmr_A_freq_ctrl write = %#v", bus.writes[0])
+	}
+	if bus.writes[1][0] != 0x11 || bus.writes[1][1] != 100 {
+		t.Errorf("Tmr_A_reg write = %#v", bus.writes[1])
+	}
+
+	got := bus.writes[3]
+	want := (byte(0xFF) &^ byte(0x06)) | 0x02 // TAC[2:1] = 01 (countdown)
+	if got[0] != 0x0F || got[1] != want {
+		t.Errorf("Tmr_CLKOUT_ctrl write = %#v, want addr 0x0F value %#x", got, want)
+	}
+}
+
+func TestConfigureTimerAWatchdog(t *testing.T) {
+	bus := &fakeBus{reads: [][]byte{{0x00}}}
+	d := newTestDevice(bus)
+
+	if err := d.ConfigureTimerA(TimerWatchdog, TimerSource4096Hz, 5); err != nil {
+		t.Fatalf("ConfigureTimerA: %v", err)
+	}
+
+	got := bus.writes[3]
+	want := byte(0x04) // TAC[2:1] = 10 (watchdog)
+	if got[0] != 0x0F || got[1] != want {
+		t.Errorf("Tmr_CLKOUT_ctrl write = %#v, want addr 0x0F value %#x", got, want)
+	}
+}
+
+func TestConfigureTimerBRejectsWatchdog(t *testing.T) {
+	bus := &fakeBus{}
+	d := newTestDevice(bus)
+
+	if err := d.ConfigureTimerB(TimerWatchdog, TimerSource1Hz, 1); err == nil {
+		t.Errorf("ConfigureTimerB(TimerWatchdog, ...) should return an error")
+	}
+}
+
+func TestConfigureTimerBEnable(t *testing.T) {
+	bus := &fakeBus{reads: [][]byte{{0x00}}}
+	d := newTestDevice(bus)
+
+	if err := d.ConfigureTimerB(TimerCountdown, TimerSource1_60Hz, 42); err != nil {
+		t.Fatalf("ConfigureTimerB: %v", err)
+	}
+
+	got := bus.writes[3]
+	if got[0] != 0x0F || got[1] != 0x01 {
+		t.Errorf("Tmr_CLKOUT_ctrl write = %#v, want addr 0x0F value 0x01 (TBC)", got)
+	}
+}
+
+func TestSetCrystalLoadPolarity(t *testing.T) {
+	cases := []struct {
+		load CrystalLoad
+		want byte
+	}{
+		{Load7pF, 0x00},
+		{Load12_5pF, 0x80},
+	}
+	for _, c := range cases {
+		bus := &fakeBus{reads: [][]byte{{0x00}}}
+		d := newTestDevice(bus)
+		if err := d.SetCrystalLoad(c.load); err != nil {
+			t.Fatalf("SetCrystalLoad: %v", err)
+		}
+		got := bus.writes[1]
+		if got[0] != 0x00 || got[1] != c.want {
+			t.Errorf("SetCrystalLoad(%v) wrote %#v, want CAP_SEL=%#x", c.load, got, c.want)
+		}
+	}
+}
+
+func TestSetTimeStopSequence(t *testing.T) {
+	bus := &fakeBus{reads: [][]byte{{0x00}}}
+	d := newTestDevice(bus)
+
+	date := time.Date(2024, time.March, 5, 13, 45, 30, 0, time.UTC)
+	if err := d.SetTime(date); err != nil {
+		t.Fatalf("SetTime: %v", err)
+	}
+
+	if len(bus.writes) != 5 {
+		t.Fatalf("got %d writes, want 5 (RMW-read, STOP-set, time, RMW-read, STOP-clear)", len(bus.writes))
+	}
+	if bus.writes[1][0] != 0x00 || bus.writes[1][1]&0x20 == 0 {
+		t.Errorf("expected STOP bit set before writing time, got %#v", bus.writes[1])
+	}
+	if bus.writes[2][0] != 0x03 {
+		t.Errorf("expected time write at 0x03, got %#v", bus.writes[2])
+	}
+	if bus.writes[2][1]&0x80 != 0 {
+		t.Errorf("seconds byte has OS bit set, want cleared: %#v", bus.writes[2])
+	}
+	if bus.writes[4][1]&0x20 != 0 {
+		t.Errorf("expected STOP bit cleared after writing time, got %#v", bus.writes[4])
+	}
+}
+
+func TestReadWriteRAMUsesSeparatePorts(t *testing.T) {
+	bus := &fakeBus{reads: [][]byte{{0xAA, 0xBB}}}
+	d := newTestDevice(bus)
+	d.variant = VariantPCF2127
+
+	buf := make([]byte, 2)
+	if err := d.ReadRAM(0x0010, buf); err != nil {
+		t.Fatalf("ReadRAM: %v", err)
+	}
+	if bus.writes[0][0] != ramAddrMsbReg {
+		t.Errorf("expected RAM address write at %#x, got %#v", ramAddrMsbReg, bus.writes[0])
+	}
+	if bus.writes[1][0] != 0x1D {
+		t.Errorf("ReadRAM should read through 0x1D, got %#v", bus.writes[1])
+	}
+	if !bytes.Equal(buf, []byte{0xAA, 0xBB}) {
+		t.Errorf("ReadRAM buf = %#v, want [0xAA 0xBB]", buf)
+	}
+
+	bus2 := &fakeBus{}
+	d2 := newTestDevice(bus2)
+	d2.variant = VariantPCF2127
+	if err := d2.WriteRAM(0x0010, []byte{0x11, 0x22}); err != nil {
+		t.Fatalf("WriteRAM: %v", err)
+	}
+	if bus2.writes[1][0] != 0x1C {
+		t.Errorf("WriteRAM should write through 0x1C, got %#v", bus2.writes[1])
+	}
+
+	unsupported := newTestDevice(&fakeBus{})
+	if err := unsupported.ReadRAM(0, buf); err == nil {
+		t.Errorf("ReadRAM on the PCF8523 variant should return an error")
+	}
+}
+
+func TestReadTamperTimestamp(t *testing.T) {
+	bus := &fakeBus{reads: [][]byte{{
+		encodeBcd(45),
+		encodeBcd(30),
+		encodeBcd(13),
+		encodeBcd(5),
+		encodeBcd(3),
+		encodeBcd(24),
+	}}}
+	d := newTestDevice(bus)
+	d.variant = VariantPCF2127
+
+	ts, err := d.ReadTamperTimestamp()
+	if err != nil {
+		t.Fatalf("ReadTamperTimestamp: %v", err)
+	}
+	if bus.writes[0][0] != tamperTimestampReg {
+		t.Errorf("expected read starting at %#x, got %#v", tamperTimestampReg, bus.writes[0])
+	}
+	want := time.Date(2024, time.March, 5, 13, 30, 45, 0, time.UTC)
+	if !ts.Equal(want) {
+		t.Errorf("ReadTamperTimestamp = %v, want %v", ts, want)
+	}
+
+	unsupported := newTestDevice(&fakeBus{})
+	if _, err := unsupported.ReadTamperTimestamp(); err == nil {
+		t.Errorf("ReadTamperTimestamp on the PCF8523 variant should return an error")
+	}
+}
+
+func TestOscillatorStopped(t *testing.T) {
+	bus := &fakeBus{reads: [][]byte{{0x80 | encodeBcd(10)}}}
+	d := newTestDevice(bus)
+
+	stopped, err := d.OscillatorStopped()
+	if err != nil {
+		t.Fatalf("OscillatorStopped: %v", err)
+	}
+	if !stopped {
+		t.Errorf("OscillatorStopped = false, want true")
+	}
+	if len(bus.writes) != 1 {
+		t.Errorf("OscillatorStopped should only read, got %d writes", len(bus.writes))
+	}
+}
+
+func TestClearOscillatorStopped(t *testing.T) {
+	bus := &fakeBus{reads: [][]byte{{0x80 | encodeBcd(10)}}}
+	d := newTestDevice(bus)
+
+	if err := d.ClearOscillatorStopped(); err != nil {
+		t.Fatalf("ClearOscillatorStopped: %v", err)
+	}
+	got := bus.writes[1]
+	if got[0] != 0x03 || got[1] != encodeBcd(10) {
+		t.Errorf("ClearOscillatorStopped wrote %#v, want seconds byte with OS cleared", got)
+	}
+}
+
+// fakePin is a minimal gpio.PinIO. Embedding the nil interface lets it
+// satisfy gpio.PinIO without stubbing out methods Subscribe never calls.
+type fakePin struct {
+	gpio.PinIO
+	edges []bool
+	idx   int
+}
+
+func (f *fakePin) In(pull gpio.Pull, edge gpio.Edge) error { return nil }
+
+func (f *fakePin) WaitForEdge(timeout time.Duration) bool {
+	if f.idx >= len(f.edges) {
+		time.Sleep(10 * time.Millisecond)
+		return false
+	}
+	e := f.edges[f.idx]
+	f.idx++
+	return e
+}
+
+func TestSubscribeDispatchesEventsAndClosesOnCancel(t *testing.T) {
+	bus := &fakeBus{reads: [][]byte{{control2Af}}}
+	d := newTestDevice(bus)
+
+	pin := &fakePin{edges: []bool{true}}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events, err := d.Subscribe(ctx, pin)
+	if err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+
+	select {
+	case e := <-events:
+		if e != AlarmEvent {
+			t.Errorf("got event %v, want AlarmEvent", e)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for AlarmEvent")
+	}
+
+	cancel()
+	select {
+	case _, ok := <-events:
+		if ok {
+			t.Errorf("expected events channel to close after cancel")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for events channel to close")
+	}
+}